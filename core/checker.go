@@ -0,0 +1,99 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+	"github.com/axiaoxin-com/x-stock/model"
+)
+
+// Checker 对单只股票进行基本面检查，汇总不符合选股规则的缺陷项
+type Checker struct {
+	ctx    context.Context
+	Stock  model.Stock
+	Filter eastmoney.Filter
+}
+
+// NewChecker 创建一个 Checker
+func NewChecker(ctx context.Context, stock model.Stock, filter eastmoney.Filter) *Checker {
+	return &Checker{
+		ctx:    ctx,
+		Stock:  stock,
+		Filter: filter,
+	}
+}
+
+// CheckFundamentals 检查基本面数据，返回 key 为检查项、value 为缺陷描述的 map，
+// 返回空 map 表示该股票通过全部基本面检查
+func (c *Checker) CheckFundamentals(ctx context.Context) map[string]string {
+	defects := map[string]string{}
+	// TODO: ROE、EPS、营收、净利润、负债率等既有规则的具体校验逻辑
+	c.checkTopHolders(defects)
+	c.checkQuarterlyReport(defects)
+	return defects
+}
+
+// checkQuarterlyReport 检查季报新鲜度与业绩超预期情况，写入 defects：
+// - 最新季报报告期过旧（可能是停牌或披露滞后）
+// - 业绩超预期幅度不足 MinEarningsSurprise
+func (c *Checker) checkQuarterlyReport(defects map[string]string) {
+	if c.Filter.MaxReportAgeDays > 0 && c.Stock.LatestReportDate != "" {
+		reportDate, err := time.Parse("2006-01-02", c.Stock.LatestReportDate)
+		if err == nil {
+			age := time.Since(reportDate)
+			if age > time.Duration(c.Filter.MaxReportAgeDays)*24*time.Hour {
+				defects["ReportTooOld"] = "最新季报报告期过旧，疑似停牌或披露滞后"
+			}
+		}
+	}
+	if c.Filter.MinEarningsSurprise > 0 && c.Stock.EarningsSurprise < c.Filter.MinEarningsSurprise {
+		defects["EarningsSurpriseTooLow"] = "最新季度净利润同比增速未明显超过近 4 季度均值"
+	}
+}
+
+// checkTopHolders 检查十大股东持股集中度及机构持股情况，写入 defects：
+// - 持股过于集中或过于分散
+// - 机构股东（基金）数量不足
+// - 十大股东合计持股比例最近 N 个报告期未连续上升
+func (c *Checker) checkTopHolders(defects map[string]string) {
+	holders := c.Stock.TopHolders
+	if len(holders) > 0 {
+		var top10Ratio float64
+		var fundCount int
+		for _, h := range holders {
+			top10Ratio += h.HoldRatio
+			if h.HolderType == "基金" {
+				fundCount++
+			}
+		}
+
+		if c.Filter.MinTop10HoldRatio > 0 && top10Ratio < c.Filter.MinTop10HoldRatio {
+			defects["TopHoldersRatioTooLow"] = "十大股东合计持股比例过低，股权过于分散"
+		}
+		if c.Filter.MaxTop10HoldRatio > 0 && top10Ratio > c.Filter.MaxTop10HoldRatio {
+			defects["TopHoldersRatioTooHigh"] = "十大股东合计持股比例过高，股权过于集中"
+		}
+		if c.Filter.MinFundHolderCount > 0 && fundCount < c.Filter.MinFundHolderCount {
+			defects["FundHolderCountTooLow"] = "十大股东中基金类机构股东数量不足"
+		}
+	}
+
+	if c.Filter.Top10IncreasingQuarters > 0 && !top10RatioIncreasing(c.Stock.TopHoldersRatioHistory, c.Filter.Top10IncreasingQuarters) {
+		defects["TopHoldersNotIncreasing"] = "十大股东合计持股比例最近几个报告期未连续上升，疑似内部人减持而机构退出"
+	}
+}
+
+// top10RatioIncreasing 判断 history（按报告期从新到旧排列的十大股东合计持股比例）最近 quarters 期
+// 是否逐期环比上升；history 不足 quarters 期时视为不满足
+func top10RatioIncreasing(history []float64, quarters int) bool {
+	if len(history) < quarters {
+		return false
+	}
+	for i := 0; i < quarters-1; i++ {
+		if history[i] < history[i+1] {
+			return false
+		}
+	}
+	return true
+}
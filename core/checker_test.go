@@ -0,0 +1,117 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+	"github.com/axiaoxin-com/x-stock/model"
+)
+
+func newTestStockWithHolders(holders []model.Holder) model.Stock {
+	return model.Stock{
+		BaseInfo:   eastmoney.StockInfo{SecurityNameAbbr: "测试"},
+		TopHolders: holders,
+	}
+}
+
+func TestCheckTopHolders_Empty(t *testing.T) {
+	checker := NewChecker(context.Background(), model.Stock{}, eastmoney.Filter{MinTop10HoldRatio: 10})
+	defects := map[string]string{}
+	checker.checkTopHolders(defects)
+	if len(defects) != 0 {
+		t.Fatalf("expected no defects when there are no holders, got %v", defects)
+	}
+}
+
+func TestCheckTopHolders_RatioTooLow(t *testing.T) {
+	stock := newTestStockWithHolders([]model.Holder{
+		{HoldRatio: 5},
+		{HoldRatio: 5},
+	})
+	checker := NewChecker(context.Background(), stock, eastmoney.Filter{MinTop10HoldRatio: 20})
+	defects := map[string]string{}
+	checker.checkTopHolders(defects)
+	if _, ok := defects["TopHoldersRatioTooLow"]; !ok {
+		t.Fatalf("expected TopHoldersRatioTooLow defect, got %v", defects)
+	}
+}
+
+func TestCheckTopHolders_RatioTooHigh(t *testing.T) {
+	stock := newTestStockWithHolders([]model.Holder{
+		{HoldRatio: 40},
+		{HoldRatio: 40},
+	})
+	checker := NewChecker(context.Background(), stock, eastmoney.Filter{MaxTop10HoldRatio: 50})
+	defects := map[string]string{}
+	checker.checkTopHolders(defects)
+	if _, ok := defects["TopHoldersRatioTooHigh"]; !ok {
+		t.Fatalf("expected TopHoldersRatioTooHigh defect, got %v", defects)
+	}
+}
+
+func TestCheckTopHolders_FundHolderCountTooLow(t *testing.T) {
+	stock := newTestStockWithHolders([]model.Holder{
+		{HoldRatio: 5, HolderType: "基金"},
+		{HoldRatio: 5, HolderType: "个人"},
+	})
+	checker := NewChecker(context.Background(), stock, eastmoney.Filter{MinFundHolderCount: 2})
+	defects := map[string]string{}
+	checker.checkTopHolders(defects)
+	if _, ok := defects["FundHolderCountTooLow"]; !ok {
+		t.Fatalf("expected FundHolderCountTooLow defect, got %v", defects)
+	}
+}
+
+func TestCheckTopHolders_Top10IncreasingQuarters(t *testing.T) {
+	t.Run("flags when the trend dips within the required window", func(t *testing.T) {
+		stock := model.Stock{
+			BaseInfo:               eastmoney.StockInfo{SecurityNameAbbr: "测试"},
+			TopHoldersRatioHistory: []float64{30, 28, 32}, // 从新到旧排列：28 低于再上一期 32，出现下降
+		}
+		checker := NewChecker(context.Background(), stock, eastmoney.Filter{Top10IncreasingQuarters: 3})
+		defects := map[string]string{}
+		checker.checkTopHolders(defects)
+		if _, ok := defects["TopHoldersNotIncreasing"]; !ok {
+			t.Fatalf("expected TopHoldersNotIncreasing defect, got %v", defects)
+		}
+	})
+
+	t.Run("passes when ratio is non-decreasing across every quarter in the window", func(t *testing.T) {
+		stock := model.Stock{
+			BaseInfo:               eastmoney.StockInfo{SecurityNameAbbr: "测试"},
+			TopHoldersRatioHistory: []float64{32, 30, 28}, // 从新到旧排列，逐期环比上升
+		}
+		checker := NewChecker(context.Background(), stock, eastmoney.Filter{Top10IncreasingQuarters: 3})
+		defects := map[string]string{}
+		checker.checkTopHolders(defects)
+		if len(defects) != 0 {
+			t.Fatalf("expected no defects, got %v", defects)
+		}
+	})
+
+	t.Run("flags when history is shorter than the required window", func(t *testing.T) {
+		stock := model.Stock{
+			BaseInfo:               eastmoney.StockInfo{SecurityNameAbbr: "测试"},
+			TopHoldersRatioHistory: []float64{32, 30},
+		}
+		checker := NewChecker(context.Background(), stock, eastmoney.Filter{Top10IncreasingQuarters: 3})
+		defects := map[string]string{}
+		checker.checkTopHolders(defects)
+		if _, ok := defects["TopHoldersNotIncreasing"]; !ok {
+			t.Fatalf("expected TopHoldersNotIncreasing defect when history is too short, got %v", defects)
+		}
+	})
+}
+
+func TestTop10RatioIncreasing(t *testing.T) {
+	if !top10RatioIncreasing([]float64{32, 30, 28}, 3) {
+		t.Fatalf("expected strictly non-decreasing (newest-first) history to satisfy the trend")
+	}
+	if top10RatioIncreasing([]float64{30, 32, 28}, 3) {
+		t.Fatalf("expected a dip to fail the trend")
+	}
+	if top10RatioIncreasing([]float64{32, 30}, 3) {
+		t.Fatalf("expected history shorter than the required window to fail")
+	}
+}
@@ -0,0 +1,71 @@
+package core
+
+import (
+	"sort"
+
+	"github.com/axiaoxin-com/x-stock/model"
+)
+
+// DiversifyOptions DiversifyByIndustry 的配置项
+type DiversifyOptions struct {
+	MaxPerIndustry    int                // 每个行业最多保留的股票数，0 表示不限制
+	MaxTotal          int                // 全局最多保留的股票数，0 表示不限制
+	MinSectorStrength float64            // 行业整体强度下限（全行业近 3 日平均涨幅），用于提前剔除弱势行业
+	SectorStrength    map[string]float64 // 行业 -> 近 3 日平均涨幅，由调用方预先统计好传入
+}
+
+// DiversifyByIndustry 按行业分散持仓：先按行业整体强度剔除弱势行业，
+// 再对每个行业内部按综合得分排序、截断到 MaxPerIndustry，最后按综合得分截断到全局 MaxTotal。
+// 实现选股规则中“行业要分散”的要求，避免结果被单一热门行业占满。
+func DiversifyByIndustry(result model.StockList, opts DiversifyOptions) model.StockList {
+	grouped := map[string]model.StockList{}
+	var industries []string
+	for _, stock := range result {
+		industry := stock.BaseInfo.Industry
+		if opts.SectorStrength != nil {
+			if strength, ok := opts.SectorStrength[industry]; ok && strength < opts.MinSectorStrength {
+				continue
+			}
+		}
+		if _, ok := grouped[industry]; !ok {
+			industries = append(industries, industry)
+		}
+		grouped[industry] = append(grouped[industry], stock)
+	}
+	sort.Strings(industries)
+
+	diversified := model.StockList{}
+	for _, industry := range industries {
+		stocks := grouped[industry]
+		sort.SliceStable(stocks, func(i, j int) bool {
+			return compositeScore(stocks[i]) > compositeScore(stocks[j])
+		})
+		if opts.MaxPerIndustry > 0 && len(stocks) > opts.MaxPerIndustry {
+			stocks = stocks[:opts.MaxPerIndustry]
+		}
+		diversified = append(diversified, stocks...)
+	}
+
+	sort.SliceStable(diversified, func(i, j int) bool {
+		return compositeScore(diversified[i]) > compositeScore(diversified[j])
+	})
+	if opts.MaxTotal > 0 && len(diversified) > opts.MaxTotal {
+		diversified = diversified[:opts.MaxTotal]
+	}
+	return diversified
+}
+
+// compositeScore 综合 ROE、净利润增长率、PEG、负债率计算的排序得分，分数越高越优：
+// PEG 与负债率越低越好，因此取其倒数项；PEG 非正时视为最差，不计倒数项避免除零或符号错误，
+// 负债率非正时同样不计倒数项。
+func compositeScore(stock model.Stock) float64 {
+	fina := stock.FinaData
+	score := fina.ROE + fina.NetprofitGrowthRate
+	if fina.PEG > 0 {
+		score += 1 / fina.PEG
+	}
+	if fina.DebtRatio > 0 {
+		score += 1 / fina.DebtRatio
+	}
+	return score
+}
@@ -0,0 +1,98 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+	"github.com/axiaoxin-com/x-stock/model"
+)
+
+func newTestStock(name, industry string, roe, netprofitGrowth, peg, debtRatio float64) model.Stock {
+	return model.Stock{
+		BaseInfo: eastmoney.StockInfo{SecurityNameAbbr: name, Industry: industry},
+		FinaData: model.FinaData{
+			ROE:                 roe,
+			NetprofitGrowthRate: netprofitGrowth,
+			PEG:                 peg,
+			DebtRatio:           debtRatio,
+		},
+	}
+}
+
+func TestCompositeScore(t *testing.T) {
+	better := newTestStock("A", "银行", 20, 10, 1, 30)
+	worse := newTestStock("B", "银行", 5, 2, 2, 60)
+	if compositeScore(better) <= compositeScore(worse) {
+		t.Fatalf("expected better stock to score higher: got better=%v worse=%v", compositeScore(better), compositeScore(worse))
+	}
+
+	// PEG 非正时不应参与倒数项计算（避免除零/符号错误）
+	zeroPEG := newTestStock("C", "银行", 20, 10, 0, 30)
+	negativePEG := newTestStock("D", "银行", 20, 10, -5, 30)
+	if compositeScore(zeroPEG) != compositeScore(negativePEG) {
+		t.Fatalf("expected non-positive PEG to be treated identically, got zero=%v negative=%v", compositeScore(zeroPEG), compositeScore(negativePEG))
+	}
+}
+
+func TestDiversifyByIndustry(t *testing.T) {
+	result := model.StockList{
+		newTestStock("银行A", "银行", 25, 10, 1, 30),
+		newTestStock("银行B", "银行", 20, 8, 1, 30),
+		newTestStock("银行C", "银行", 15, 5, 1, 30),
+		newTestStock("医药A", "医药", 18, 12, 1, 20),
+	}
+
+	diversified := DiversifyByIndustry(result, DiversifyOptions{MaxPerIndustry: 2})
+	counts := map[string]int{}
+	for _, s := range diversified {
+		counts[s.BaseInfo.Industry]++
+	}
+	if counts["银行"] != 2 {
+		t.Fatalf("expected MaxPerIndustry=2 to cap 银行 at 2 stocks, got %d", counts["银行"])
+	}
+	if counts["医药"] != 1 {
+		t.Fatalf("expected 医药 to keep its single stock, got %d", counts["医药"])
+	}
+
+	var names []string
+	for _, s := range diversified {
+		names = append(names, s.BaseInfo.SecurityNameAbbr)
+	}
+	if len(names) > 0 && names[0] != "银行A" {
+		t.Fatalf("expected highest composite score 银行A to rank first, got %v", names)
+	}
+}
+
+func TestDiversifyByIndustry_MinSectorStrength(t *testing.T) {
+	result := model.StockList{
+		newTestStock("银行A", "银行", 25, 10, 1, 30),
+		newTestStock("医药A", "医药", 18, 12, 1, 20),
+	}
+
+	diversified := DiversifyByIndustry(result, DiversifyOptions{
+		MinSectorStrength: 0,
+		SectorStrength: map[string]float64{
+			"银行": -1, // 弱势行业，整体剔除
+			"医药": 2,
+		},
+	})
+	for _, s := range diversified {
+		if s.BaseInfo.Industry == "银行" {
+			t.Fatalf("expected 银行 sector to be excluded for falling below MinSectorStrength, got %v", diversified)
+		}
+	}
+}
+
+func TestDiversifyByIndustry_MaxTotal(t *testing.T) {
+	result := model.StockList{
+		newTestStock("银行A", "银行", 25, 10, 1, 30),
+		newTestStock("医药A", "医药", 18, 12, 1, 20),
+	}
+	diversified := DiversifyByIndustry(result, DiversifyOptions{MaxTotal: 1})
+	if len(diversified) != 1 {
+		t.Fatalf("expected MaxTotal=1 to cap result to 1 stock, got %d", len(diversified))
+	}
+	if diversified[0].BaseInfo.SecurityNameAbbr != "银行A" {
+		t.Fatalf("expected the highest scoring stock to survive MaxTotal, got %v", diversified[0].BaseInfo.SecurityNameAbbr)
+	}
+}
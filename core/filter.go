@@ -1,6 +1,6 @@
 // filter 对给定股票进行分析，筛出其中的优质公司。（好公司，但不代表当前股价在涨）
 // 选股规则：
-// 行业要分散
+// 行业要分散（按行业打分截断，并可剔除整体走弱的行业）
 // 最新 ROE 高于 8%
 // ROE 平均值小于 20 时，至少 3 年内逐年递增
 // EPS 至少 3 年内逐年递增
@@ -9,6 +9,13 @@
 // 估值较低或中等
 // 股价低于合理价格
 // 负债率低于 60%
+// 十大股东持股集中度适中，机构股东数量合理，且未出现内部人减持而机构退出的迹象
+// 近期公告中未披露处罚、冻结、诉讼等风险事项
+// 可选限定为两融标的，融资余额不低于指定阈值
+// 最新季报报告期不过旧（默认 120 天内，超过视为停牌或披露滞后）；
+// 业绩超预期幅度（最新季度净利润同比增速相对近 4 季度均值）可选用作硬性门槛，默认仅作为排序时的平分依据
+//
+// 可选在筛选完成后生成包含 K 线、财务柱状图、PEG 散点图及行业树图的交互式 HTML 报告
 
 package core
 
@@ -47,8 +54,26 @@ var DefaultFilter = eastmoney.Filter{
 	ListingOver5Y:            false,
 	ExcludeCYB:               true,
 	ExcludeKCB:               true,
+	MinTop10HoldRatio:        0.0,
+	MaxTop10HoldRatio:        0.0,
+	MinFundHolderCount:       0,
+	Top10IncreasingQuarters:  0,
+	RiskNoticeLookbackDays:   180,
+	RiskKeywords:             eastmoney.DefaultRiskKeywords,
+	MaxRiskHits:              0,
+	MaxPerIndustry:           3,
+	MinSectorStrength:        0.0,
+	MaxTotal:                 0,
+	OnlyMarginTradingTargets: false,
+	MinRZYE:                  0.0,
+	MaxReportAgeDays:         120,
+	MinEarningsSurprise:      0.0,
+	GenerateHTMLReport:       false,
 }
 
+// DefaultHTMLReportPath 默认的 HTML 报告输出路径
+const DefaultHTMLReportPath = "./x-stock_report.html"
+
 // AutoFilterStocks 按默认设置自动筛选股票
 func AutoFilterStocks(ctx context.Context) (model.StockList, error) {
 	return AutoFilterStocksWithFilter(ctx, DefaultFilter)
@@ -86,8 +111,20 @@ func AutoFilterStocksWithFilter(ctx context.Context, filter eastmoney.Filter) (r
 				return
 			}
 
-			checker := NewChecker(ctx, stock)
-			if defects := checker.CheckFundamentals(ctx); len(defects) == 0 {
+			if filter.OnlyMarginTradingTargets && !stock.MarginTradingTarget {
+				return
+			}
+			if filter.MinRZYE > 0 && stock.RZYE < filter.MinRZYE {
+				return
+			}
+
+			checker := NewChecker(ctx, stock, filter)
+			defects := checker.CheckFundamentals(ctx)
+			if err := checkRiskNotice(ctx, stock.BaseInfo.Secucode, filter, defects); err != nil {
+				logging.Error(ctx, "checkRiskNotice error:"+err.Error())
+				return
+			}
+			if len(defects) == 0 {
 				result = append(result, stock)
 			} else {
 				logging.Info(ctx, fmt.Sprintf("%s %s has some defects", stock.BaseInfo.SecurityNameAbbr, stock.BaseInfo.Secucode), zap.Any("defects", defects))
@@ -97,5 +134,25 @@ func AutoFilterStocksWithFilter(ctx context.Context, filter eastmoney.Filter) (r
 	wg.Wait()
 	logging.Infof(ctx, "AutoFilterStocksWithFilter selected %d stocks", len(result))
 	result.SortByROE()
+
+	if filter.MaxPerIndustry > 0 || filter.MinSectorStrength > 0 || filter.MaxTotal > 0 {
+		sectorStrength, err := datacenter.EastMoney.QuerySectorStrength(ctx)
+		if err != nil {
+			return result, err
+		}
+		result = DiversifyByIndustry(result, DiversifyOptions{
+			MaxPerIndustry:    filter.MaxPerIndustry,
+			MaxTotal:          filter.MaxTotal,
+			MinSectorStrength: filter.MinSectorStrength,
+			SectorStrength:    sectorStrength,
+		})
+		logging.Infof(ctx, "AutoFilterStocksWithFilter diversified to %d stocks", len(result))
+	}
+
+	if filter.GenerateHTMLReport {
+		if err := ExportHTMLReport(ctx, result, DefaultHTMLReportPath); err != nil {
+			logging.Error(ctx, "ExportHTMLReport error:"+err.Error())
+		}
+	}
 	return
-}
\ No newline at end of file
+}
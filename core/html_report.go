@@ -0,0 +1,172 @@
+package core
+
+import (
+	"context"
+	"os"
+
+	"github.com/axiaoxin-com/logging"
+	"github.com/axiaoxin-com/x-stock/datacenter"
+	"github.com/axiaoxin-com/x-stock/model"
+	"github.com/go-echarts/go-echarts/v2/charts"
+	"github.com/go-echarts/go-echarts/v2/components"
+	"github.com/go-echarts/go-echarts/v2/opts"
+)
+
+// klineLookbackDays HTML 报告中 K 线图回溯的交易日数
+const klineLookbackDays = 250
+
+// ExportHTMLReport 将筛选结果渲染为一个自包含的交互式 HTML 报告（基于 go-echarts），
+// 包含每只股票的 K 线图（叠加 MA5/MA10/MA20）、近 3 年 ROE/EPS/营收/净利润柱状图、
+// 组合内 PE-增速散点图（用于直观展示 PEG），以及按市值加权的行业持仓矩形树图，
+// 便于用户离线查看每只股票入选的依据。
+func ExportHTMLReport(ctx context.Context, result model.StockList, path string) error {
+	page := components.NewPage()
+	page.PageTitle = "选股结果报告"
+
+	page.AddCharts(newPEGScatter(result), newIndustryTreemap(result))
+	for _, stock := range result {
+		kline, err := newKlineChart(ctx, stock)
+		if err != nil {
+			logging.Error(ctx, "newKlineChart error:"+err.Error())
+			continue
+		}
+		page.AddCharts(kline, newYearlyFinaBar(stock))
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return page.Render(f)
+}
+
+// newKlineChart 绘制指定股票最近 klineLookbackDays 个交易日的 K 线图，叠加 MA5/MA10/MA20
+func newKlineChart(ctx context.Context, stock model.Stock) (*charts.Kline, error) {
+	points, err := datacenter.EastMoney.QueryKline(ctx, stock.BaseInfo.Secucode, klineLookbackDays)
+	if err != nil {
+		return nil, err
+	}
+
+	kline := charts.NewKLine()
+	kline.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{
+			Title: stock.BaseInfo.SecurityNameAbbr + " K线图",
+		}),
+	)
+
+	dates := make([]string, 0, len(points))
+	klineData := make([]opts.KlineData, 0, len(points))
+	closes := make([]float64, 0, len(points))
+	for _, p := range points {
+		dates = append(dates, p.Date)
+		klineData = append(klineData, opts.KlineData{Value: [4]float64{p.Open, p.Close, p.Low, p.High}})
+		closes = append(closes, p.Close)
+	}
+
+	kline.SetXAxis(dates).AddSeries("K线", klineData)
+
+	maLine := charts.NewLine()
+	maLine.SetXAxis(dates).
+		AddSeries("MA5", movingAverage(closes, 5)).
+		AddSeries("MA10", movingAverage(closes, 10)).
+		AddSeries("MA20", movingAverage(closes, 20))
+	kline.Overlap(maLine)
+	return kline, nil
+}
+
+// movingAverage 计算 closes 的 n 日移动平均线，不足 n 个交易日的位置返回 0
+func movingAverage(closes []float64, n int) []opts.LineData {
+	ma := make([]opts.LineData, len(closes))
+	for i := range closes {
+		if i+1 < n {
+			ma[i] = opts.LineData{Value: 0}
+			continue
+		}
+		var sum float64
+		for _, c := range closes[i+1-n : i+1] {
+			sum += c
+		}
+		ma[i] = opts.LineData{Value: sum / float64(n)}
+	}
+	return ma
+}
+
+// newYearlyFinaBar 绘制指定股票近 3 年 ROE/EPS/营业总收入/净利润柱状图
+func newYearlyFinaBar(stock model.Stock) *charts.Bar {
+	bar := charts.NewBar()
+	bar.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{
+			Title: stock.BaseInfo.SecurityNameAbbr + " 近3年财务指标",
+		}),
+	)
+
+	years := make([]string, 0, len(stock.YearlyFinaHistory))
+	roe := make([]opts.BarData, 0, len(stock.YearlyFinaHistory))
+	eps := make([]opts.BarData, 0, len(stock.YearlyFinaHistory))
+	toi := make([]opts.BarData, 0, len(stock.YearlyFinaHistory))
+	netprofit := make([]opts.BarData, 0, len(stock.YearlyFinaHistory))
+	for _, y := range stock.YearlyFinaHistory {
+		years = append(years, y.Year)
+		roe = append(roe, opts.BarData{Value: y.ROE})
+		eps = append(eps, opts.BarData{Value: y.EPS})
+		toi = append(toi, opts.BarData{Value: y.TOI})
+		netprofit = append(netprofit, opts.BarData{Value: y.Netprofit})
+	}
+
+	bar.SetXAxis(years).
+		AddSeries("ROE", roe).
+		AddSeries("EPS", eps).
+		AddSeries("营业总收入", toi).
+		AddSeries("净利润", netprofit)
+	return bar
+}
+
+// newPEGScatter 绘制组合内全部股票的 PE-增速散点图，用于直观展示 PEG 水平
+func newPEGScatter(result model.StockList) *charts.Scatter {
+	scatter := charts.NewScatter()
+	scatter.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{Title: "PE-增速散点图（PEG 可视化）"}),
+		charts.WithXAxisOpts(opts.XAxis{Name: "净利润增长率(%)"}),
+		charts.WithYAxisOpts(opts.YAxis{Name: "市盈率(PE)"}),
+	)
+
+	data := make([]opts.ScatterData, 0, len(result))
+	for _, stock := range result {
+		data = append(data, opts.ScatterData{
+			Name:  stock.BaseInfo.SecurityNameAbbr,
+			Value: [2]float64{stock.FinaData.NetprofitGrowthRate, stock.FinaData.PE},
+		})
+	}
+	scatter.AddSeries("PEG", data)
+	return scatter
+}
+
+// newIndustryTreemap 按行业对组合持仓分组，以总市值加权绘制矩形树图
+func newIndustryTreemap(result model.StockList) *charts.TreeMap {
+	grouped := map[string][]opts.TreeMapNode{}
+	for _, stock := range result {
+		industry := stock.BaseInfo.Industry
+		grouped[industry] = append(grouped[industry], opts.TreeMapNode{
+			Name: stock.BaseInfo.SecurityNameAbbr,
+			// TreeMapNode.Value 为 int，以元为单位截断总市值的小数部分，
+			// 对动辄百亿元级别的市值而言该截断可忽略不计，只影响矩形树图的相对面积展示
+			Value: int(stock.FinaData.TotalMarketCap),
+		})
+	}
+
+	roots := make([]opts.TreeMapNode, 0, len(grouped))
+	for industry, children := range grouped {
+		roots = append(roots, opts.TreeMapNode{
+			Name:     industry,
+			Children: children,
+		})
+	}
+
+	treemap := charts.NewTreeMap()
+	treemap.SetGlobalOptions(
+		charts.WithTitleOpts(opts.Title{Title: "持仓行业分布（按市值加权）"}),
+	)
+	treemap.AddSeries("行业分布", roots)
+	return treemap
+}
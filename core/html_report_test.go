@@ -0,0 +1,34 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/go-echarts/go-echarts/v2/opts"
+)
+
+func TestMovingAverage(t *testing.T) {
+	closes := []float64{1, 2, 3, 4, 5}
+
+	got := movingAverage(closes, 3)
+	want := []opts.LineData{
+		{Value: 0},
+		{Value: 0},
+		{Value: 2.0}, // (1+2+3)/3
+		{Value: 3.0}, // (2+3+4)/3
+		{Value: 4.0}, // (3+4+5)/3
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("movingAverage(3) = %v, want %v", got, want)
+	}
+}
+
+func TestMovingAverage_WindowLargerThanInput(t *testing.T) {
+	closes := []float64{1, 2}
+	got := movingAverage(closes, 5)
+	for i, v := range got {
+		if v.Value != 0 {
+			t.Fatalf("movingAverage()[%d] = %v, want 0 when window exceeds input length", i, v.Value)
+		}
+	}
+}
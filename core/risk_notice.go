@@ -0,0 +1,93 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/axiaoxin-com/x-stock/datacenter"
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+)
+
+// announcementPageSize 每次请求拉取的公告条数
+const announcementPageSize = 100
+
+// RiskNoticeChecker 基于公司公告关键词的风险筛查子系统，从最近公告中检索风险披露关键词
+type RiskNoticeChecker struct {
+	ctx    context.Context
+	Filter eastmoney.Filter
+}
+
+// NewRiskNoticeChecker 创建一个 RiskNoticeChecker
+func NewRiskNoticeChecker(ctx context.Context, filter eastmoney.Filter) *RiskNoticeChecker {
+	return &RiskNoticeChecker{
+		ctx:    ctx,
+		Filter: filter,
+	}
+}
+
+// Check 拉取 secucode 最近的公告，命中风险关键词的次数超过 Filter.MaxRiskHits 时返回非空 defects，
+// key 为命中的关键词，value 为触发该关键词的公告标题
+func (r *RiskNoticeChecker) Check(ctx context.Context, secucode string) (map[string]string, error) {
+	announcements, err := datacenter.EastMoney.QueryAnnouncements(ctx, secucode, announcementPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	keywords := r.Filter.RiskKeywords
+	if len(keywords) == 0 {
+		keywords = eastmoney.DefaultRiskKeywords
+	}
+
+	return matchRiskKeywords(announcements, keywords, r.Filter.RiskNoticeLookbackDays, r.Filter.MaxRiskHits), nil
+}
+
+// matchRiskKeywords 在 announcements 中检索 keywords，跳过超出 lookbackDays 的公告（0 表示不限制），
+// 命中总数不超过 maxHits 时视为未触发风险；否则返回 keyword -> 触发公告标题 的 defects map
+func matchRiskKeywords(announcements []eastmoney.Announcement, keywords []string, lookbackDays int, maxHits int) map[string]string {
+	defects := map[string]string{}
+	hits := 0
+	for _, ann := range announcements {
+		if lookbackDays > 0 && !withinLookback(ann.NoticeDate, lookbackDays) {
+			continue
+		}
+		for _, kw := range keywords {
+			if strings.Contains(ann.Title, kw) {
+				hits++
+				if _, exists := defects[kw]; !exists {
+					defects[kw] = ann.Title
+				}
+			}
+		}
+	}
+
+	if hits <= maxHits {
+		return map[string]string{}
+	}
+	return defects
+}
+
+// withinLookback 判断 noticeDate（格式 2006-01-02）是否落在距今 lookbackDays 天以内，
+// 日期解析失败时保守地认为其在窗口内，避免因脏数据漏检风险公告
+func withinLookback(noticeDate string, lookbackDays int) bool {
+	t, err := time.Parse("2006-01-02", noticeDate)
+	if err != nil {
+		return true
+	}
+	return time.Since(t) <= time.Duration(lookbackDays)*24*time.Hour
+}
+
+// checkRiskNotice 对单只股票执行风险公告筛查，并将命中的关键词与公告标题写入 defects，
+// key 形如 "RiskNotice:处罚" 以便与其他检查项区分
+func checkRiskNotice(ctx context.Context, secucode string, filter eastmoney.Filter, defects map[string]string) error {
+	checker := NewRiskNoticeChecker(ctx, filter)
+	hits, err := checker.Check(ctx, secucode)
+	if err != nil {
+		return err
+	}
+	for keyword, title := range hits {
+		defects[fmt.Sprintf("RiskNotice:%s", keyword)] = title
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+)
+
+func TestMatchRiskKeywords(t *testing.T) {
+	today := time.Now().Format("2006-01-02")
+	old := time.Now().AddDate(0, 0, -200).Format("2006-01-02")
+
+	announcements := []eastmoney.Announcement{
+		{Title: "关于公司涉及诉讼的公告", NoticeDate: today},
+		{Title: "关于股东股权被冻结的公告", NoticeDate: old},
+		{Title: "2026年第一季度报告", NoticeDate: today},
+	}
+
+	t.Run("hits within lookback exceed maxHits", func(t *testing.T) {
+		defects := matchRiskKeywords(announcements, []string{"诉讼", "冻结"}, 180, 0)
+		if _, ok := defects["诉讼"]; !ok {
+			t.Fatalf("expected 诉讼 to be flagged, got %v", defects)
+		}
+		if _, ok := defects["冻结"]; ok {
+			t.Fatalf("expected 冻结 announcement outside lookback window to be excluded, got %v", defects)
+		}
+	})
+
+	t.Run("hits within maxHits do not trigger", func(t *testing.T) {
+		defects := matchRiskKeywords(announcements, []string{"诉讼"}, 180, 1)
+		if len(defects) != 0 {
+			t.Fatalf("expected no defects when hits <= maxHits, got %v", defects)
+		}
+	})
+
+	t.Run("no keyword match", func(t *testing.T) {
+		defects := matchRiskKeywords(announcements, []string{"破产"}, 0, 0)
+		if len(defects) != 0 {
+			t.Fatalf("expected no defects, got %v", defects)
+		}
+	})
+}
+
+func TestWithinLookback(t *testing.T) {
+	recent := time.Now().AddDate(0, 0, -5).Format("2006-01-02")
+	if !withinLookback(recent, 30) {
+		t.Fatalf("expected %s to be within 30 day lookback", recent)
+	}
+
+	stale := time.Now().AddDate(0, 0, -60).Format("2006-01-02")
+	if withinLookback(stale, 30) {
+		t.Fatalf("expected %s to be outside 30 day lookback", stale)
+	}
+
+	if !withinLookback("not-a-date", 30) {
+		t.Fatalf("expected unparseable dates to be treated as within lookback")
+	}
+}
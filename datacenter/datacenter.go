@@ -0,0 +1,9 @@
+// Package datacenter 聚合各数据源的请求客户端，供 core、model 等上层包使用
+package datacenter
+
+import (
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+)
+
+// EastMoney 全局东方财富网数据请求客户端
+var EastMoney = eastmoney.NewEastMoney()
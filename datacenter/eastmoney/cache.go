@@ -0,0 +1,36 @@
+package eastmoney
+
+import (
+	"sync"
+	"time"
+)
+
+// announcementCacheTTL 公告缓存有效期，避免全市场扫描期间对公告接口的重复请求
+var announcementCacheTTL = 30 * time.Minute
+
+type announcementCacheEntry struct {
+	data      []Announcement
+	expiresAt time.Time
+}
+
+var announcementCache sync.Map // secucode -> announcementCacheEntry
+
+func getCachedAnnouncements(secucode string) ([]Announcement, bool) {
+	v, ok := announcementCache.Load(secucode)
+	if !ok {
+		return nil, false
+	}
+	entry := v.(announcementCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		announcementCache.Delete(secucode)
+		return nil, false
+	}
+	return entry.data, true
+}
+
+func setCachedAnnouncements(secucode string, data []Announcement) {
+	announcementCache.Store(secucode, announcementCacheEntry{
+		data:      data,
+		expiresAt: time.Now().Add(announcementCacheTTL),
+	})
+}
@@ -0,0 +1,271 @@
+// Package eastmoney 封装东方财富网相关数据接口的请求客户端
+package eastmoney
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// StockInfo 东方财富网返回的股票基本信息
+type StockInfo struct {
+	Secucode         string // 证券代码，如 000001.SZ
+	SecurityNameAbbr string // 证券简称
+	Industry         string // 所属行业
+}
+
+// EastMoney 东方财富网数据请求客户端
+type EastMoney struct{}
+
+// NewEastMoney 创建 EastMoney 请求客户端
+func NewEastMoney() *EastMoney {
+	return &EastMoney{}
+}
+
+// QuerySelectedStocksWithFilter 根据条件在东方财富网选股器中筛选股票基本信息列表
+func (e *EastMoney) QuerySelectedStocksWithFilter(ctx context.Context, filter Filter) ([]StockInfo, error) {
+	// TODO: 请求东方财富网选股器接口，根据 filter 中的条件拼接请求参数
+	return nil, nil
+}
+
+// HolderInfo 十大股东/机构持股明细，对应 RPT_DMSK_HOLDERS 接口返回的单条记录
+type HolderInfo struct {
+	HolderName  string  // 股东名称
+	HolderType  string  // 股东类型：国家队/基金/QFII/个人等
+	HoldNum     int64   // 持股数量（股）
+	HoldRatio   float64 // 占流通股比例（%）
+	RatioChange float64 // 较上一季度持股比例变动（百分点）
+}
+
+// QueryTopHolders 请求 RPT_DMSK_HOLDERS 接口，获取指定股票最新一期的十大股东及机构持股明细。
+// 先按 SECURITY_CODE 过滤、按 END_DATE 倒序取 1 条记录确定最新报告期，
+// 再按该报告期 + SECURITY_CODE 过滤、pageSize=10、按 RANK 正序取十大股东明细。
+func (e *EastMoney) QueryTopHolders(ctx context.Context, secucode string) ([]HolderInfo, error) {
+	securityCode := strings.Split(secucode, ".")[0]
+
+	latest, err := queryDatacenter(ctx, "RPT_DMSK_HOLDERS", fmt.Sprintf(`(SECURITY_CODE="%s")`, securityCode), "END_DATE", "-1", 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(latest) == 0 {
+		return nil, nil
+	}
+	endDate := fieldString(latest[0], "END_DATE")
+
+	rows, err := queryDatacenter(ctx, "RPT_DMSK_HOLDERS", fmt.Sprintf(`(SECURITY_CODE="%s")(END_DATE='%s')`, securityCode, endDate), "RANK", "1", 10, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]HolderInfo, 0, len(rows))
+	for _, row := range rows {
+		holders = append(holders, HolderInfo{
+			HolderName:  fieldString(row, "HOLDER_NAME"),
+			HolderType:  fieldString(row, "HOLDER_TYPE"),
+			HoldNum:     fieldInt64(row, "HOLD_NUM"),
+			HoldRatio:   fieldFloat(row, "HOLD_NUM_RATIO"),
+			RatioChange: fieldFloat(row, "HOLD_RATIO_QOQ"),
+		})
+	}
+	return holders, nil
+}
+
+// topHoldersHistoryMaxQuarters QueryTopHoldersHistory 最多回溯的报告期数
+const topHoldersHistoryMaxQuarters = 8
+
+// TopHoldersPeriod 某一报告期十大股东合计持股比例
+type TopHoldersPeriod struct {
+	EndDate    string  // 报告期，格式 2006-01-02
+	Top10Ratio float64 // 该报告期十大股东合计持股比例（%）
+}
+
+// QueryTopHoldersHistory 请求 RPT_DMSK_HOLDERS 接口，按 SECURITY_CODE 过滤、按 END_DATE 倒序取
+// 最近 topHoldersHistoryMaxQuarters 个报告期的十大股东明细，按报告期汇总持股比例，
+// 用于判断十大股东合计持股比例是否逐季上升
+func (e *EastMoney) QueryTopHoldersHistory(ctx context.Context, secucode string) ([]TopHoldersPeriod, error) {
+	securityCode := strings.Split(secucode, ".")[0]
+
+	rows, err := queryDatacenter(ctx, "RPT_DMSK_HOLDERS", fmt.Sprintf(`(SECURITY_CODE="%s")`, securityCode), "END_DATE", "-1", topHoldersHistoryMaxQuarters*10, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	var order []string
+	sums := map[string]float64{}
+	for _, row := range rows {
+		endDate := fieldString(row, "END_DATE")
+		if _, seen := sums[endDate]; !seen {
+			if len(order) >= topHoldersHistoryMaxQuarters {
+				continue
+			}
+			order = append(order, endDate)
+		}
+		sums[endDate] += fieldFloat(row, "HOLD_NUM_RATIO")
+	}
+
+	periods := make([]TopHoldersPeriod, 0, len(order))
+	for _, endDate := range order {
+		periods = append(periods, TopHoldersPeriod{EndDate: endDate, Top10Ratio: sums[endDate]})
+	}
+	return periods, nil
+}
+
+// sectorStrengthLookbackDays QuerySectorStrength 统计平均涨幅所用的交易日数
+const sectorStrengthLookbackDays = 3
+
+// industryBoard 行业板块基本信息
+type industryBoard struct {
+	Code string // 板块代码
+	Name string // 板块名称
+}
+
+// industryBoardListResponse push2.eastmoney.com/api/qt/clist/get 行业板块列表接口的响应结构
+type industryBoardListResponse struct {
+	Data struct {
+		Diff []struct {
+			Code string `json:"f12"`
+			Name string `json:"f14"`
+		} `json:"diff"`
+	} `json:"data"`
+}
+
+// queryIndustryBoards 请求 push2.eastmoney.com/api/qt/clist/get 接口，获取全部申万行业板块列表
+func queryIndustryBoards(ctx context.Context) ([]industryBoard, error) {
+	q := url.Values{}
+	q.Set("pn", "1")
+	q.Set("pz", "200")
+	q.Set("fs", "m:90 t:2") // 行业板块
+	q.Set("fields", "f12,f14")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://push2.eastmoney.com/api/qt/clist/get?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var parsed industryBoardListResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, err
+	}
+
+	boards := make([]industryBoard, 0, len(parsed.Data.Diff))
+	for _, item := range parsed.Data.Diff {
+		boards = append(boards, industryBoard{Code: item.Code, Name: item.Name})
+	}
+	return boards, nil
+}
+
+// QuerySectorStrength 统计各行业板块近 sectorStrengthLookbackDays 个交易日的平均涨幅，
+// 用于在按行业分散持仓前整体剔除弱势行业
+func (e *EastMoney) QuerySectorStrength(ctx context.Context) (map[string]float64, error) {
+	boards, err := queryIndustryBoards(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	strength := make(map[string]float64, len(boards))
+	for _, board := range boards {
+		klines, err := fetchKline(ctx, "90."+board.Code, sectorStrengthLookbackDays)
+		if err != nil {
+			return nil, err
+		}
+		strength[board.Name] = averageDailyChangeRatio(klines)
+	}
+	return strength, nil
+}
+
+// averageDailyChangeRatio 计算 K 线序列中每日涨跌幅（收盘价相对开盘价）的平均值（%）
+func averageDailyChangeRatio(klines []KlinePoint) float64 {
+	if len(klines) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, k := range klines {
+		if k.Open == 0 {
+			continue
+		}
+		sum += (k.Close - k.Open) / k.Open * 100
+	}
+	return sum / float64(len(klines))
+}
+
+// Announcement 公司公告信息
+type Announcement struct {
+	Title      string // 公告标题
+	NoticeDate string // 公告日期，格式 2006-01-02
+}
+
+// announcementResponse np-anotice-stock.eastmoney.com/api/security/ann 接口的响应结构
+type announcementResponse struct {
+	Success bool `json:"success"`
+	Data    struct {
+		List []struct {
+			Title      string `json:"title"`
+			NoticeDate string `json:"notice_date"`
+		} `json:"list"`
+	} `json:"data"`
+}
+
+// QueryAnnouncements 请求 np-anotice-stock.eastmoney.com/api/security/ann 接口，
+// 获取指定股票最近 pageSize 条公司公告，结果按公告日期从新到旧排序，并缓存 announcementCacheTTL。
+func (e *EastMoney) QueryAnnouncements(ctx context.Context, secucode string, pageSize int) ([]Announcement, error) {
+	if cached, ok := getCachedAnnouncements(secucode); ok {
+		return cached, nil
+	}
+
+	securityCode := strings.Split(secucode, ".")[0]
+	q := url.Values{}
+	q.Set("sr", "-1")
+	q.Set("page_size", strconv.Itoa(pageSize))
+	q.Set("page_index", "1")
+	q.Set("ann_type", "A")
+	q.Set("client_source", "web")
+	q.Set("stock_list", securityCode)
+	q.Set("f_node", "0")
+	q.Set("s_node", "0")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://np-anotice-stock.eastmoney.com/api/security/ann?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var parsed announcementResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, err
+	}
+	if !parsed.Success {
+		return nil, fmt.Errorf("eastmoney announcement request failed: secucode=%s", secucode)
+	}
+
+	result := make([]Announcement, 0, len(parsed.Data.List))
+	for _, item := range parsed.Data.List {
+		result = append(result, Announcement{
+			Title:      item.Title,
+			NoticeDate: item.NoticeDate,
+		})
+	}
+	setCachedAnnouncements(secucode, result)
+	return result, nil
+}
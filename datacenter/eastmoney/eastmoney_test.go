@@ -0,0 +1,22 @@
+package eastmoney
+
+import "testing"
+
+func TestAverageDailyChangeRatio(t *testing.T) {
+	klines := []KlinePoint{
+		{Open: 10, Close: 11}, // +10%
+		{Open: 20, Close: 19}, // -5%
+		{Open: 0, Close: 5},   // Open=0 时跳过，避免除零
+	}
+	got := averageDailyChangeRatio(klines)
+	want := (10.0 - 5.0) / 3
+	if got != want {
+		t.Fatalf("averageDailyChangeRatio() = %v, want %v", got, want)
+	}
+}
+
+func TestAverageDailyChangeRatio_Empty(t *testing.T) {
+	if got := averageDailyChangeRatio(nil); got != 0 {
+		t.Fatalf("averageDailyChangeRatio(nil) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,62 @@
+package eastmoney
+
+// Filter 选股过滤条件
+type Filter struct {
+	MinROE                   float64 // 最新 ROE 至少要达到多少
+	MinNetprofitYoyRatio     float64 // 净利润同比增长率至少
+	MinToiYoyRatio           float64 // 营业总收入同比增长率至少
+	MinZXGXL                 float64 // 最新估值相对增长率至少
+	MinNetprofitGrowthrate3Y float64 // 净利润 3 年复合增长率至少
+	MinIncomeGrowthrate3Y    float64 // 营收 3 年复合增长率至少
+	MinListingYieldYear      float64 // 上市以来年化收益率至少
+	MinPBNewMRQ              float64 // 最新市净率至少
+	MinPredictNetprofitRatio float64 // 预测净利润增长率至少
+	MinPredictIncomeRatio    float64 // 预测营收增长率至少
+	MinTotalMarketCap        float64 // 总市值至少（元）
+	Industry                 string  // 指定行业
+	MinPrice                 float64 // 股价下限
+	MaxPrice                 float64 // 股价上限
+	ListingOver5Y            bool    // 上市超过 5 年
+	ExcludeCYB               bool    // 排除创业板
+	ExcludeKCB               bool    // 排除科创板
+
+	// 十大股东/机构持股相关条件
+	MinTop10HoldRatio       float64 // 十大股东合计持股比例下限，过低代表股权过于分散
+	MaxTop10HoldRatio       float64 // 十大股东合计持股比例上限，过高代表股权过于集中
+	MinFundHolderCount      int     // 十大股东中基金类股东数量下限
+	Top10IncreasingQuarters int     // 要求十大股东合计持股比例连续上升的报告期数，0 表示不检查该趋势
+
+	// 风险公告筛查相关条件
+	RiskNoticeLookbackDays int      // 检索最近多少天内的公告，0 表示不限制天数
+	RiskKeywords           []string // 命中即判定存在风险的公告关键词列表，为空使用 DefaultRiskKeywords
+	MaxRiskHits            int      // 允许命中风险关键词的次数（一条公告命中多个关键词时分别计数），超过则判定有缺陷，默认 0 表示一票否决
+
+	// 行业分散相关条件
+	MaxPerIndustry    int     // 每个行业最多保留的股票数，0 表示不限制
+	MinSectorStrength float64 // 行业整体强度下限（全行业近 3 日平均涨幅），低于此值的行业整体剔除
+	MaxTotal          int     // 分散持仓后全局最多保留的股票数，0 表示不限制
+
+	// 两融（融资融券）相关条件
+	OnlyMarginTradingTargets bool    // 只保留两融标的
+	MinRZYE                  float64 // 融资余额下限（元），0 表示不限制
+
+	// 季报新鲜度与业绩超预期相关条件
+	MaxReportAgeDays    int     // 最新季报报告期距今天数上限，超过视为报告过旧，0 表示不限制
+	MinEarningsSurprise float64 // 最新季度净利润同比增速相对近 4 季度均值的超预期幅度下限
+
+	GenerateHTMLReport bool // 是否在自动筛选完成后额外生成交互式 HTML 报告
+}
+
+// DefaultRiskKeywords 默认的风险公告关键词列表
+var DefaultRiskKeywords = []string{
+	"处罚",
+	"冻结",
+	"诉讼",
+	"质押",
+	"仲裁",
+	"信用减值",
+	"商誉减值",
+	"重大风险",
+	"退市风险",
+	"持股5%以上股东权益变动",
+}
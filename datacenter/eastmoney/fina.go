@@ -0,0 +1,134 @@
+package eastmoney
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// YearlyFinaIndicator 单一年报口径（REPORT_DATE 为当年 12-31）的核心财务指标
+type YearlyFinaIndicator struct {
+	Year      string  // 年度，如 "2023"
+	ROE       float64 // 净资产收益率（%）
+	EPS       float64 // 每股收益
+	TOI       float64 // 营业总收入（元）
+	Netprofit float64 // 净利润（元）
+}
+
+// FinaIndicatorResult 综合主要财务指标接口与实时行情接口得到的核心财务指标
+type FinaIndicatorResult struct {
+	ROE                 float64               // 最新一期净资产收益率（%）
+	NetprofitGrowthRate float64               // 最新一期净利润同比增长率（%）
+	PE                  float64               // 最新市盈率（动态）
+	PEG                 float64               // PE / NetprofitGrowthRate
+	DebtRatio           float64               // 最新一期资产负债率（%）
+	TotalMarketCap      float64               // 最新总市值（元）
+	Yearly              []YearlyFinaIndicator // 近 3 个年报口径的逐年数据，按年度从新到旧排列
+}
+
+// QueryFinaData 请求 RPT_F10_FINANCE_MAINFINADATA 主要财务指标接口取最新一期及近 3 年年报数据，
+// realtime 为 true 时再叠加实时行情接口的 PE、总市值计算 PEG，为 false 时跳过该次额外请求，
+// PE、PEG、TotalMarketCap 保持零值
+func (e *EastMoney) QueryFinaData(ctx context.Context, secucode string, realtime bool) (FinaIndicatorResult, error) {
+	var result FinaIndicatorResult
+
+	rows, err := queryDatacenter(ctx, "RPT_F10_FINANCE_MAINFINADATA", `(SECUCODE="`+secucode+`")`, "REPORT_DATE", "-1", 20, 1)
+	if err != nil {
+		return result, err
+	}
+	if len(rows) > 0 {
+		latest := rows[0]
+		result.ROE = fieldFloat(latest, "ROEWEIGHT")
+		result.NetprofitGrowthRate = fieldFloat(latest, "NETPROFITRATIO")
+		result.DebtRatio = fieldFloat(latest, "DEBTASSETSRATIO")
+	}
+	for _, row := range rows {
+		reportDate := fieldString(row, "REPORT_DATE")
+		if !strings.HasSuffix(reportDate, "12-31") || len(reportDate) < 4 {
+			continue
+		}
+		if len(result.Yearly) >= 3 {
+			break
+		}
+		result.Yearly = append(result.Yearly, YearlyFinaIndicator{
+			Year:      reportDate[:4],
+			ROE:       fieldFloat(row, "ROEWEIGHT"),
+			EPS:       fieldFloat(row, "EPSJB"),
+			TOI:       fieldFloat(row, "TOTALOPERATEREVE"),
+			Netprofit: fieldFloat(row, "PARENTNETPROFIT"),
+		})
+	}
+
+	if !realtime {
+		return result, nil
+	}
+
+	securityCode := strings.Split(secucode, ".")[0]
+	quote, err := e.QueryRealtimeQuote(ctx, securityCode)
+	if err != nil {
+		return result, err
+	}
+	result.PE = quote.PE
+	result.TotalMarketCap = quote.TotalMarketCap
+	if result.NetprofitGrowthRate > 0 {
+		result.PEG = result.PE / result.NetprofitGrowthRate
+	}
+	return result, nil
+}
+
+// RealtimeQuote 实时行情中用于估值展示的关键字段
+type RealtimeQuote struct {
+	PE             float64 // 市盈率（动态）
+	TotalMarketCap float64 // 总市值（元）
+}
+
+// realtimeQuoteResponse push2.eastmoney.com/api/qt/stock/get 接口的响应结构
+type realtimeQuoteResponse struct {
+	Data struct {
+		F9   float64 `json:"f9"`   // 市盈率（动态）
+		F116 float64 `json:"f116"` // 总市值
+	} `json:"data"`
+}
+
+// QueryRealtimeQuote 请求 push2.eastmoney.com/api/qt/stock/get 获取最新市盈率与总市值
+func (e *EastMoney) QueryRealtimeQuote(ctx context.Context, securityCode string) (RealtimeQuote, error) {
+	var quote RealtimeQuote
+
+	q := url.Values{}
+	q.Set("secid", toSecID(securityCode))
+	q.Set("fields", "f9,f116")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://push2.eastmoney.com/api/qt/stock/get?"+q.Encode(), nil)
+	if err != nil {
+		return quote, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return quote, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return quote, err
+	}
+	var parsed realtimeQuoteResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return quote, err
+	}
+	quote.PE = parsed.Data.F9
+	quote.TotalMarketCap = parsed.Data.F116
+	return quote, nil
+}
+
+// toSecID 将 6 位证券代码转换为东方财富行情接口使用的 secid：
+// 沪市（6、9 开头）为 1.code，深市/北交所为 0.code
+func toSecID(securityCode string) string {
+	if strings.HasPrefix(securityCode, "6") || strings.HasPrefix(securityCode, "9") {
+		return "1." + securityCode
+	}
+	return "0." + securityCode
+}
@@ -0,0 +1,89 @@
+package eastmoney
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// KlinePoint 单日 K 线数据
+type KlinePoint struct {
+	Date   string  // 交易日，格式 2006-01-02
+	Open   float64 // 开盘价
+	Close  float64 // 收盘价
+	Low    float64 // 最低价
+	High   float64 // 最高价
+	Volume int64   // 成交量（股）
+}
+
+// klineResponse push2his.eastmoney.com/api/qt/stock/kline/get 接口的响应结构，
+// klines 每一项为逗号分隔的 "日期,开盘,收盘,最高,最低,成交量,..."
+type klineResponse struct {
+	Data struct {
+		Klines []string `json:"klines"`
+	} `json:"data"`
+}
+
+// QueryKline 请求东方财富网历史行情接口，获取指定股票最近 days 个交易日的日 K 线数据，
+// 结果按交易日从旧到新排序，用于绘制 K 线图及 MA 均线
+func (e *EastMoney) QueryKline(ctx context.Context, secucode string, days int) ([]KlinePoint, error) {
+	securityCode := strings.Split(secucode, ".")[0]
+	return fetchKline(ctx, toSecID(securityCode), days)
+}
+
+// fetchKline 请求东方财富网历史行情接口，获取 secid 最近 days 个交易日的日 K 线数据，
+// 结果按交易日从旧到新排序；secid 既可以是个股（toSecID 转换），也可以是行业板块（90. 前缀）
+func fetchKline(ctx context.Context, secid string, days int) ([]KlinePoint, error) {
+	q := url.Values{}
+	q.Set("secid", secid)
+	q.Set("fields1", "f1,f2,f3,f4,f5")
+	q.Set("fields2", "f51,f52,f53,f54,f55,f56")
+	q.Set("klt", "101") // 101 表示日 K
+	q.Set("fqt", "1")   // 前复权
+	q.Set("lmt", strconv.Itoa(days))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://push2his.eastmoney.com/api/qt/stock/kline/get?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var parsed klineResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, err
+	}
+
+	points := make([]KlinePoint, 0, len(parsed.Data.Klines))
+	for _, line := range parsed.Data.Klines {
+		fields := strings.Split(line, ",")
+		if len(fields) < 6 {
+			continue
+		}
+		open, _ := strconv.ParseFloat(fields[1], 64)
+		close_, _ := strconv.ParseFloat(fields[2], 64)
+		high, _ := strconv.ParseFloat(fields[3], 64)
+		low, _ := strconv.ParseFloat(fields[4], 64)
+		volume, _ := strconv.ParseInt(fields[5], 10, 64)
+		points = append(points, KlinePoint{
+			Date:   fields[0],
+			Open:   open,
+			Close:  close_,
+			High:   high,
+			Low:    low,
+			Volume: volume,
+		})
+	}
+	return points, nil
+}
@@ -0,0 +1,76 @@
+package eastmoney
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// marginTargetCacheTTL 两融标的列表缓存有效期，两融标的名单每个交易日更新一次
+var marginTargetCacheTTL = 24 * time.Hour
+
+// marginTargetPageSize 每页拉取的两融标的条数
+const marginTargetPageSize = 500
+
+// marginTargetMaxPages 全量分页拉取的页数上限，避免接口异常分页不到头时死循环
+const marginTargetMaxPages = 20
+
+// MarginTarget 两融（融资融券）标的信息，对应 RPTA_WEB_RZRQ_GGMX 接口返回的单条记录
+type MarginTarget struct {
+	Secucode string  // 证券代码
+	RZYE     float64 // 融资余额（元）
+	RQYL     float64 // 融券余量（股）
+}
+
+var (
+	marginTargetCacheMu      sync.Mutex
+	marginTargetCacheData    map[string]MarginTarget
+	marginTargetCacheExpires time.Time
+)
+
+// QueryMarginTradingTargets 分页请求 RPTA_WEB_RZRQ_GGMX 接口，获取沪深两融标的名单及融资融券余额，
+// 首次请求后缓存全量数据，marginTargetCacheTTL（24 小时）内复用缓存结果。
+func (e *EastMoney) QueryMarginTradingTargets(ctx context.Context) (map[string]MarginTarget, error) {
+	marginTargetCacheMu.Lock()
+	defer marginTargetCacheMu.Unlock()
+
+	if marginTargetCacheData != nil && time.Now().Before(marginTargetCacheExpires) {
+		return marginTargetCacheData, nil
+	}
+
+	data := map[string]MarginTarget{}
+	for page := 1; page <= marginTargetMaxPages; page++ {
+		rows, err := queryDatacenter(ctx, "RPTA_WEB_RZRQ_GGMX", "", "TRADE_DATE", "-1", marginTargetPageSize, page)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		parseMarginTargets(rows, data)
+		if len(rows) < marginTargetPageSize {
+			break
+		}
+	}
+
+	marginTargetCacheData = data
+	marginTargetCacheExpires = time.Now().Add(marginTargetCacheTTL)
+	return data, nil
+}
+
+// parseMarginTargets 将 RPTA_WEB_RZRQ_GGMX 接口单页返回的记录解析后写入 into，
+// 结果按 TRADE_DATE 倒序分页返回，因此每只股票只保留第一次出现（即最新交易日）的记录，
+// 避免跨页重复拉到同一股票的历史交易日数据时覆盖最新的 RZYE/RQYL
+func parseMarginTargets(rows []map[string]interface{}, into map[string]MarginTarget) {
+	for _, row := range rows {
+		secucode := fieldString(row, "SECUCODE")
+		if _, exists := into[secucode]; exists {
+			continue
+		}
+		into[secucode] = MarginTarget{
+			Secucode: secucode,
+			RZYE:     fieldFloat(row, "RZYE"),
+			RQYL:     fieldFloat(row, "RQYL"),
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package eastmoney
+
+import "testing"
+
+func TestParseMarginTargets(t *testing.T) {
+	rows := []map[string]interface{}{
+		{"SECUCODE": "000001.SZ", "RZYE": 123.45, "RQYL": "6789"},
+		{"SECUCODE": "600000.SH", "RZYE": "10", "RQYL": 20.0},
+	}
+
+	got := map[string]MarginTarget{}
+	parseMarginTargets(rows, got)
+	if len(got) != 2 {
+		t.Fatalf("parseMarginTargets() populated %d targets, want 2", len(got))
+	}
+
+	want := MarginTarget{Secucode: "000001.SZ", RZYE: 123.45, RQYL: 6789}
+	if got["000001.SZ"] != want {
+		t.Fatalf("parseMarginTargets()[000001.SZ] = %+v, want %+v", got["000001.SZ"], want)
+	}
+
+	if got["600000.SH"].RZYE != 10 {
+		t.Fatalf("parseMarginTargets()[600000.SH].RZYE = %v, want 10, string-encoded numbers should parse", got["600000.SH"].RZYE)
+	}
+}
+
+func TestParseMarginTargets_KeepsFirstOccurrencePerStock(t *testing.T) {
+	// 跨页拉到同一股票的更早交易日数据时，应保留第一次出现（即最新交易日）的记录
+	rows := []map[string]interface{}{
+		{"SECUCODE": "000001.SZ", "RZYE": 100.0, "RQYL": 200.0},
+		{"SECUCODE": "000001.SZ", "RZYE": 50.0, "RQYL": 60.0},
+	}
+
+	got := map[string]MarginTarget{}
+	parseMarginTargets(rows, got)
+	want := MarginTarget{Secucode: "000001.SZ", RZYE: 100, RQYL: 200}
+	if got["000001.SZ"] != want {
+		t.Fatalf("parseMarginTargets() = %+v, want first occurrence %+v", got["000001.SZ"], want)
+	}
+}
+
+func TestParseMarginTargets_DoesNotOverwriteAcrossCalls(t *testing.T) {
+	// 模拟跨页调用：第一页已写入的记录不应被后续页覆盖
+	into := map[string]MarginTarget{
+		"000001.SZ": {Secucode: "000001.SZ", RZYE: 100, RQYL: 200},
+	}
+	parseMarginTargets([]map[string]interface{}{
+		{"SECUCODE": "000001.SZ", "RZYE": 1.0, "RQYL": 2.0},
+	}, into)
+
+	want := MarginTarget{Secucode: "000001.SZ", RZYE: 100, RQYL: 200}
+	if into["000001.SZ"] != want {
+		t.Fatalf("parseMarginTargets() overwrote existing entry: got %+v, want %+v", into["000001.SZ"], want)
+	}
+}
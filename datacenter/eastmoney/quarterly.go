@@ -0,0 +1,73 @@
+package eastmoney
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// quarterlyReportCacheTTL 季报数据缓存有效期
+var quarterlyReportCacheTTL = 6 * time.Hour
+
+// QuarterlyReport 单只股票最新一期季报的关键指标，对应 RPT_LICO_FN_CPD 接口返回的单条记录
+type QuarterlyReport struct {
+	Secucode          string  // 证券代码
+	ReportDate        string  // 报告期，格式 2006-01-02
+	NetprofitYoyRatio float64 // 本期净利润同比增长率（%）
+}
+
+var (
+	quarterlyReportCacheMu      sync.Mutex
+	quarterlyReportCacheData    map[string][]QuarterlyReport
+	quarterlyReportCacheExpires time.Time
+)
+
+// quarterlyReportPageSize 每页拉取的季报条数
+const quarterlyReportPageSize = 500
+
+// quarterlyReportMaxPages 全量分页拉取的页数上限，避免接口异常分页不到头时死循环
+const quarterlyReportMaxPages = 50
+
+// quarterlyReportsPerStock 每只股票最多保留的季报期数，用于计算近 4 季度同比均值
+const quarterlyReportsPerStock = 5
+
+// QueryQuarterlyReports 分页请求 RPT_LICO_FN_CPD 接口，按 REPORTDATE 倒序拉取全量季报，
+// 返回 secucode -> 按报告期从新到旧排列、最多 quarterlyReportsPerStock 期的季报列表，
+// 结果缓存 quarterlyReportCacheTTL。
+func (e *EastMoney) QueryQuarterlyReports(ctx context.Context) (map[string][]QuarterlyReport, error) {
+	quarterlyReportCacheMu.Lock()
+	defer quarterlyReportCacheMu.Unlock()
+
+	if quarterlyReportCacheData != nil && time.Now().Before(quarterlyReportCacheExpires) {
+		return quarterlyReportCacheData, nil
+	}
+
+	data := map[string][]QuarterlyReport{}
+	for page := 1; page <= quarterlyReportMaxPages; page++ {
+		rows, err := queryDatacenter(ctx, "RPT_LICO_FN_CPD", "", "REPORTDATE", "-1", quarterlyReportPageSize, page)
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == 0 {
+			break
+		}
+		for _, row := range rows {
+			secucode := fieldString(row, "SECUCODE")
+			if existing := data[secucode]; len(existing) >= quarterlyReportsPerStock {
+				continue
+			}
+			data[secucode] = append(data[secucode], QuarterlyReport{
+				Secucode:          secucode,
+				ReportDate:        fieldString(row, "REPORTDATE"),
+				NetprofitYoyRatio: fieldFloat(row, "SJLTZ"),
+			})
+		}
+		if len(rows) < quarterlyReportPageSize {
+			break
+		}
+	}
+
+	quarterlyReportCacheData = data
+	quarterlyReportCacheExpires = time.Now().Add(quarterlyReportCacheTTL)
+	return data, nil
+}
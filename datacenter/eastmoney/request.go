@@ -0,0 +1,90 @@
+package eastmoney
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// httpClient 请求东方财富网各接口使用的公共 HTTP 客户端
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+const datacenterAPI = "https://datacenter-web.eastmoney.com/api/data/v1/get"
+
+// datacenterResponse datacenter-web.eastmoney.com/api/data/v1/get 接口的通用响应结构
+type datacenterResponse struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+	Result  struct {
+		Data []map[string]interface{} `json:"data"`
+	} `json:"result"`
+}
+
+// queryDatacenter 请求 datacenter-web.eastmoney.com/api/data/v1/get 通用分页接口，
+// 按 filter 过滤、sortColumns/sortTypes 排序，返回原始记录列表
+func queryDatacenter(ctx context.Context, reportName, filter, sortColumns, sortTypes string, pageSize, pageNumber int) ([]map[string]interface{}, error) {
+	q := url.Values{}
+	q.Set("reportName", reportName)
+	if filter != "" {
+		q.Set("filter", filter)
+	}
+	if sortColumns != "" {
+		q.Set("sortColumns", sortColumns)
+		q.Set("sortTypes", sortTypes)
+	}
+	q.Set("pageSize", strconv.Itoa(pageSize))
+	q.Set("pageNumber", strconv.Itoa(pageNumber))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, datacenterAPI+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var parsed datacenterResponse
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		return nil, err
+	}
+	if !parsed.Success {
+		return nil, fmt.Errorf("eastmoney datacenter request failed: reportName=%s message=%s", reportName, parsed.Message)
+	}
+	return parsed.Result.Data, nil
+}
+
+// fieldString 从 datacenter-web 返回的单条记录中取出字符串字段，缺失时返回空字符串
+func fieldString(row map[string]interface{}, key string) string {
+	if v, ok := row[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+// fieldFloat 从 datacenter-web 返回的单条记录中取出数值字段，兼容接口把数字编码为字符串的情况
+func fieldFloat(row map[string]interface{}, key string) float64 {
+	switch v := row[key].(type) {
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return 0
+}
+
+// fieldInt64 从 datacenter-web 返回的单条记录中取出整数字段
+func fieldInt64(row map[string]interface{}, key string) int64 {
+	return int64(fieldFloat(row, key))
+}
@@ -0,0 +1,157 @@
+// Package model 定义股票相关的领域模型
+package model
+
+import (
+	"context"
+	"sort"
+
+	"github.com/axiaoxin-com/x-stock/datacenter"
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+)
+
+// Stock 一只股票的完整信息，供 core.Checker 进行选股判断
+type Stock struct {
+	BaseInfo   eastmoney.StockInfo // 股票基本信息
+	TopHolders []Holder            // 前十大股东及机构持股情况
+	FinaData   FinaData            // 核心财务指标
+
+	// TopHoldersRatioHistory 最近若干报告期十大股东合计持股比例，按报告期从新到旧排列，
+	// 用于判断十大股东合计持股比例是否逐季上升
+	TopHoldersRatioHistory []float64
+
+	MarginTradingTarget bool    // 是否为融资融券标的
+	RZYE                float64 // 融资余额（元）
+	RQYL                float64 // 融券余量（股）
+
+	LatestReportDate string  // 最新季报报告期，格式 2006-01-02
+	EarningsSurprise float64 // 最新季度净利润同比增速 - 最近 4 个季度平均同比增速
+
+	YearlyFinaHistory []YearlyFina // 近 3 年逐年核心财务指标，用于 HTML 报告中的柱状图展示
+}
+
+// YearlyFina 单一年度的核心财务指标
+type YearlyFina struct {
+	Year      string  // 年度，如 "2023"
+	ROE       float64 // 净资产收益率（%）
+	EPS       float64 // 每股收益
+	TOI       float64 // 营业总收入（元）
+	Netprofit float64 // 净利润（元）
+}
+
+// FinaData 核心财务指标，用于基本面检查及排序打分
+type FinaData struct {
+	ROE                 float64 // 最新净资产收益率（%）
+	NetprofitGrowthRate float64 // 净利润增长率（%）
+	PE                  float64 // 市盈率（动态）
+	PEG                 float64 // PEG 估值指标
+	DebtRatio           float64 // 资产负债率（%）
+	TotalMarketCap      float64 // 总市值（元）
+}
+
+// Holder 十大股东/机构持股明细
+type Holder struct {
+	HolderName  string  // 股东名称
+	HolderType  string  // 股东类型：国家队/基金/QFII/个人等
+	HoldNum     int64   // 持股数量（股）
+	HoldRatio   float64 // 占流通股比例（%）
+	RatioChange float64 // 较上一季度持股比例变动（百分点）
+}
+
+// NewStock 根据股票基本信息构建 Stock，realtime 为 true 时附带抓取最新行情
+func NewStock(ctx context.Context, baseInfo eastmoney.StockInfo, realtime bool) (Stock, error) {
+	stock := Stock{
+		BaseInfo: baseInfo,
+	}
+	holders, err := datacenter.EastMoney.QueryTopHolders(ctx, baseInfo.Secucode)
+	if err != nil {
+		return stock, err
+	}
+	for _, h := range holders {
+		stock.TopHolders = append(stock.TopHolders, Holder{
+			HolderName:  h.HolderName,
+			HolderType:  h.HolderType,
+			HoldNum:     h.HoldNum,
+			HoldRatio:   h.HoldRatio,
+			RatioChange: h.RatioChange,
+		})
+	}
+
+	topHoldersHistory, err := datacenter.EastMoney.QueryTopHoldersHistory(ctx, baseInfo.Secucode)
+	if err != nil {
+		return stock, err
+	}
+	for _, p := range topHoldersHistory {
+		stock.TopHoldersRatioHistory = append(stock.TopHoldersRatioHistory, p.Top10Ratio)
+	}
+
+	marginTargets, err := datacenter.EastMoney.QueryMarginTradingTargets(ctx)
+	if err != nil {
+		return stock, err
+	}
+	if target, ok := marginTargets[baseInfo.Secucode]; ok {
+		stock.MarginTradingTarget = true
+		stock.RZYE = target.RZYE
+		stock.RQYL = target.RQYL
+	}
+
+	quarterlyReports, err := datacenter.EastMoney.QueryQuarterlyReports(ctx)
+	if err != nil {
+		return stock, err
+	}
+	if reports := quarterlyReports[baseInfo.Secucode]; len(reports) > 0 {
+		stock.LatestReportDate = reports[0].ReportDate
+		stock.EarningsSurprise = reports[0].NetprofitYoyRatio - averageYoyRatio(reports)
+	}
+
+	fina, err := datacenter.EastMoney.QueryFinaData(ctx, baseInfo.Secucode, realtime)
+	if err != nil {
+		return stock, err
+	}
+	stock.FinaData = FinaData{
+		ROE:                 fina.ROE,
+		NetprofitGrowthRate: fina.NetprofitGrowthRate,
+		PE:                  fina.PE,
+		PEG:                 fina.PEG,
+		DebtRatio:           fina.DebtRatio,
+		TotalMarketCap:      fina.TotalMarketCap,
+	}
+	for _, y := range fina.Yearly {
+		stock.YearlyFinaHistory = append(stock.YearlyFinaHistory, YearlyFina{
+			Year:      y.Year,
+			ROE:       y.ROE,
+			EPS:       y.EPS,
+			TOI:       y.TOI,
+			Netprofit: y.Netprofit,
+		})
+	}
+	return stock, nil
+}
+
+// averageYoyRatio 计算最近最多 4 个季度（不含最新一期）的净利润同比增速均值
+func averageYoyRatio(reports []eastmoney.QuarterlyReport) float64 {
+	trailing := reports[1:]
+	if len(trailing) > 4 {
+		trailing = trailing[:4]
+	}
+	if len(trailing) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, r := range trailing {
+		sum += r.NetprofitYoyRatio
+	}
+	return sum / float64(len(trailing))
+}
+
+// StockList 股票列表
+type StockList []Stock
+
+// SortByROE 按 ROE 从高到低排序，ROE 相同时以业绩超预期幅度 EarningsSurprise 作为平分时的排序依据
+func (s StockList) SortByROE() {
+	sort.Slice(s, func(i, j int) bool {
+		if s[i].FinaData.ROE != s[j].FinaData.ROE {
+			return s[i].FinaData.ROE > s[j].FinaData.ROE
+		}
+		return s[i].EarningsSurprise > s[j].EarningsSurprise
+	})
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/axiaoxin-com/x-stock/datacenter/eastmoney"
+)
+
+func TestAverageYoyRatio(t *testing.T) {
+	t.Run("averages trailing up to 4 quarters, excluding the latest", func(t *testing.T) {
+		reports := []eastmoney.QuarterlyReport{
+			{ReportDate: "2026-06-30", NetprofitYoyRatio: 40}, // 最新一期，不参与均值计算
+			{ReportDate: "2026-03-31", NetprofitYoyRatio: 10},
+			{ReportDate: "2025-12-31", NetprofitYoyRatio: 20},
+			{ReportDate: "2025-09-30", NetprofitYoyRatio: 30},
+			{ReportDate: "2025-06-30", NetprofitYoyRatio: 0},
+			{ReportDate: "2025-03-31", NetprofitYoyRatio: 100}, // 第 5 期之后的数据应被忽略
+		}
+		got := averageYoyRatio(reports)
+		want := (10.0 + 20.0 + 30.0 + 0.0) / 4
+		if got != want {
+			t.Fatalf("averageYoyRatio() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("returns 0 when there is no trailing history", func(t *testing.T) {
+		reports := []eastmoney.QuarterlyReport{
+			{ReportDate: "2026-06-30", NetprofitYoyRatio: 40},
+		}
+		if got := averageYoyRatio(reports); got != 0 {
+			t.Fatalf("averageYoyRatio() = %v, want 0", got)
+		}
+	})
+}